@@ -0,0 +1,117 @@
+// Package bktree implements a BK-tree, a metric tree that supports
+// "find all terms within edit distance k" lookups over a dictionary in
+// sublinear time.
+//
+// Reference:
+// https://en.wikipedia.org/wiki/BK-tree
+package bktree
+
+import (
+	"sort"
+
+	tdl "github.com/antlko/Damerau-Levenshtein"
+)
+
+// Match is a single hit returned by Search or AutoComplete.
+type Match struct {
+	Term     string
+	Distance int
+}
+
+type node struct {
+	term     string
+	children map[int]*node
+}
+
+// Tree is a BK-tree indexed by the given metric. The metric must be a true
+// metric (it must satisfy the triangle inequality) or Search's pruning is
+// unsound; TrueDamerauLevenshtein.Distance qualifies, the OSA variant does not.
+type Tree struct {
+	metric func(a, b string) int
+	root   *node
+}
+
+// New builds an empty Tree that uses metric to compare terms.
+func New(metric func(a, b string) int) *Tree {
+	return &Tree{metric: metric}
+}
+
+// NewDefault builds a Tree wired to a dedicated TrueDamerauLevenshtein, so
+// callers don't have to wire up the metric themselves.
+// maxSize sets an upper limit, in runes, for every term added to the tree and
+// every query passed to Search/AutoComplete.
+func NewDefault(maxSize int) *Tree {
+	d := tdl.New(maxSize)
+	return New(d.Distance)
+}
+
+// Add inserts term into the tree.
+func (t *Tree) Add(term string) {
+	if t.root == nil {
+		t.root = &node{term: term}
+		return
+	}
+	n := t.root
+	for {
+		d := t.metric(term, n.term)
+		if d == 0 {
+			return
+		}
+		if n.children == nil {
+			n.children = make(map[int]*node)
+		}
+		child, ok := n.children[d]
+		if !ok {
+			n.children[d] = &node{term: term}
+			return
+		}
+		n = child
+	}
+}
+
+// AddAll inserts every term in terms into the tree.
+func (t *Tree) AddAll(terms []string) {
+	for _, term := range terms {
+		t.Add(term)
+	}
+}
+
+// Search returns every term in the tree whose distance to query is at most
+// maxDist, pruning any subtree whose key falls outside [d-maxDist, d+maxDist].
+func (t *Tree) Search(query string, maxDist int) []Match {
+	if t.root == nil {
+		return nil
+	}
+	var matches []Match
+	var visit func(n *node)
+	visit = func(n *node) {
+		d := t.metric(query, n.term)
+		if d <= maxDist {
+			matches = append(matches, Match{Term: n.term, Distance: d})
+		}
+		for k, child := range n.children {
+			if k >= d-maxDist && k <= d+maxDist {
+				visit(child)
+			}
+		}
+	}
+	visit(t.root)
+	return matches
+}
+
+// AutoComplete is a convenience over Search: it returns the closest matches to
+// prefix, sorted by distance (then alphabetically), capped at limit results.
+// A negative limit returns every match.
+func (t *Tree) AutoComplete(prefix string, maxDist, limit int) []Match {
+	matches := t.Search(prefix, maxDist)
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Distance != matches[j].Distance {
+			return matches[i].Distance < matches[j].Distance
+		}
+		return matches[i].Term < matches[j].Term
+	})
+	if limit >= 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
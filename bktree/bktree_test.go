@@ -0,0 +1,111 @@
+package bktree
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// lenDiff is a cheap stand-in metric for tests that don't need a real edit
+// distance: |len(a)-len(b)|. It satisfies the triangle inequality, which is what
+// Search's pruning relies on, but it is only a pseudometric: two different terms
+// of the same length are at distance 0, and Add treats the second as a duplicate.
+func lenDiff(a, b string) int {
+	d := len(a) - len(b)
+	if d < 0 {
+		d = -d
+	}
+	return d
+}
+
+func TestAddSearch(t *testing.T) {
+	tr := New(lenDiff)
+	tr.AddAll([]string{"a", "bb", "ccc", "dddd", "eeeee"})
+
+	got := tr.Search("x", 1) // len 1, matches terms of len 0..2 -> "a", "bb"
+	var terms []string
+	for _, m := range got {
+		terms = append(terms, m.Term)
+	}
+	sort.Strings(terms)
+	want := []string{"a", "bb"}
+	if !reflect.DeepEqual(terms, want) {
+		t.Fatalf("Search(%q, 1) = %v, want %v", "x", terms, want)
+	}
+}
+
+func TestSearchPrunesAtMaxDistBoundary(t *testing.T) {
+	tr := New(lenDiff)
+	tr.AddAll([]string{"a", "bb", "ccc"}) // lengths 1, 2, 3
+
+	// query length 1: distances are 0, 1, 2
+	atBoundary := tr.Search("x", 1)
+	if len(atBoundary) != 2 {
+		t.Fatalf("Search at maxDist=1 returned %d matches, want 2 (distances 0 and 1)", len(atBoundary))
+	}
+	for _, m := range atBoundary {
+		if m.Distance > 1 {
+			t.Errorf("Search(maxDist=1) returned a match at distance %d", m.Distance)
+		}
+	}
+
+	justOutside := tr.Search("x", 0)
+	if len(justOutside) != 1 || justOutside[0].Term != "a" {
+		t.Fatalf("Search(maxDist=0) = %v, want just the exact-length match", justOutside)
+	}
+}
+
+func TestAddIsIdempotentForDuplicates(t *testing.T) {
+	tr := New(lenDiff)
+	tr.Add("a")
+	tr.Add("a")
+	got := tr.Search("a", 0)
+	if len(got) != 1 {
+		t.Fatalf("Search after adding a duplicate returned %d matches, want 1", len(got))
+	}
+}
+
+func TestAutoCompleteSortsAndLimits(t *testing.T) {
+	tr := New(lenDiff)
+	// Distinct lengths so lenDiff, which can't tell apart two same-length terms,
+	// doesn't collide two different terms onto the same tree node.
+	tr.AddAll([]string{"a", "bb", "ccc", "dddd", "eeeee"})
+
+	// query len 2: distances -> a:1, bb:0, ccc:1, dddd:2, eeeee:3
+	got := tr.AutoComplete("xx", 2, 3)
+	if len(got) != 3 {
+		t.Fatalf("AutoComplete limit=3 returned %d matches, want 3", len(got))
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i-1].Distance > got[i].Distance {
+			t.Fatalf("AutoComplete results not sorted by distance: %v", got)
+		}
+		if got[i-1].Distance == got[i].Distance && got[i-1].Term > got[i].Term {
+			t.Fatalf("AutoComplete results not sorted alphabetically within a distance tier: %v", got)
+		}
+	}
+	if got[0].Distance != 0 {
+		t.Fatalf("AutoComplete's first result has distance %d, want 0", got[0].Distance)
+	}
+
+	all := tr.AutoComplete("xx", 3, -1)
+	if len(all) != 5 {
+		t.Fatalf("AutoComplete with limit=-1 returned %d matches, want all 5", len(all))
+	}
+}
+
+func TestNewDefaultUsesDamerauLevenshtein(t *testing.T) {
+	tr := NewDefault(20)
+	tr.AddAll([]string{"kitten", "sitting", "hello"})
+
+	got := tr.Search("sitten", 1)
+	var terms []string
+	for _, m := range got {
+		terms = append(terms, m.Term)
+	}
+	sort.Strings(terms)
+	want := []string{"kitten"}
+	if !reflect.DeepEqual(terms, want) {
+		t.Fatalf("Search(%q, 1) = %v, want %v", "sitten", terms, want)
+	}
+}
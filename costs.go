@@ -0,0 +1,151 @@
+package tdl
+
+// Costs weights the four edit operations used by DistanceWithCosts. The zero
+// value is not useful; use unit costs (all fields set to 1) to reproduce the
+// plain Distance behavior.
+type Costs struct {
+	Insert     int
+	Delete     int
+	Substitute int
+	Transpose  int
+}
+
+// DistanceWithCosts is the same as Distance, but lets the caller weight each
+// edit operation individually, e.g. to make transpositions cheaper than
+// substitutions, a common tuning for spellcheckers since Damerau's original
+// paper found transpositions dominate real-world typos.
+func (t *TrueDamerauLevenshtein) DistanceWithCosts(a, b string, c Costs) int {
+	return t.distanceRunesWithCosts([]rune(a), []rune(b), c)
+}
+
+func (t *TrueDamerauLevenshtein) distanceRunesWithCosts(a, b []rune, c Costs) int {
+	lenA, lenB := len(a), len(b)
+	switch {
+	case lenA < 1:
+		return lenB * c.Insert
+	case lenB < 1:
+		return lenA * c.Delete
+	case lenA > t.maxSize:
+		return -1
+	case lenB > t.maxSize:
+		return -1
+	}
+
+	maxCost := -minimum(-c.Insert, -c.Delete, -c.Substitute, -c.Transpose)
+	sentinel := (lenA+lenB)*maxCost + 1
+	t.matrix[0][0] = sentinel
+	for i := 0; i <= lenA; i++ {
+		t.matrix[i+1][1] = i * c.Delete
+		t.matrix[i+1][0] = sentinel
+	}
+	for j := 0; j <= lenB; j++ {
+		t.matrix[1][j+1] = j * c.Insert
+		t.matrix[0][j+1] = sentinel
+	}
+
+	for _, r := range a {
+		t.da[r] = 0
+	}
+	for _, r := range b {
+		t.da[r] = 0
+	}
+
+	for i := 1; i <= lenA; i++ {
+		db := 0
+		for j := 1; j <= lenB; j++ {
+			i1 := t.da[b[j-1]]
+			j1 := db
+			cost := c.Substitute
+			if a[i-1] == b[j-1] {
+				cost = 0
+				db = j
+			}
+
+			t.matrix[i+1][j+1] = minimum(
+				t.matrix[i][j]+cost,       // substitution
+				t.matrix[i+1][j]+c.Insert, // insertion
+				t.matrix[i][j+1]+c.Delete, // deletion
+				t.matrix[i1][j1]+(i-i1-1)*c.Delete+c.Transpose+(j-j1-1)*c.Insert, // transposition
+			)
+		}
+		t.da[a[i-1]] = i
+	}
+	return t.matrix[lenA+1][lenB+1]
+}
+
+// DistanceWithin calculates the true Damerau–Levenshtein distance of string A and B,
+// same as Distance, but gives up as soon as it can prove the result exceeds max: once
+// every value in the row being built is already over max, no cell derived from that
+// row can bring the final distance back down to max or below. It returns (max+1, false)
+// in that case instead of finishing the full matrix, which is the standard optimization
+// for filtering a candidate list (search, dictionary lookup) down to close matches.
+func (t *TrueDamerauLevenshtein) DistanceWithin(a, b string, max int) (int, bool) {
+	ar, br := []rune(a), []rune(b)
+	lenA, lenB := len(ar), len(br)
+	switch {
+	case lenA < 1:
+		if lenB > max {
+			return max + 1, false
+		}
+		return lenB, true
+	case lenB < 1:
+		if lenA > max {
+			return max + 1, false
+		}
+		return lenA, true
+	case lenA > t.maxSize, lenB > t.maxSize:
+		return -1, false
+	}
+
+	t.matrix[0][0] = lenA + lenB
+	for i := 0; i <= lenA; i++ {
+		t.matrix[i+1][1] = i
+		t.matrix[i+1][0] = t.matrix[0][0]
+	}
+	for j := 0; j <= lenB; j++ {
+		t.matrix[1][j+1] = j
+		t.matrix[0][j+1] = t.matrix[0][0]
+	}
+
+	for _, r := range ar {
+		t.da[r] = 0
+	}
+	for _, r := range br {
+		t.da[r] = 0
+	}
+
+	for i := 1; i <= lenA; i++ {
+		db := 0
+		rowMin := lenA + lenB
+		for j := 1; j <= lenB; j++ {
+			i1 := t.da[br[j-1]]
+			j1 := db
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+				db = j
+			}
+
+			v := minimum(
+				t.matrix[i][j]+cost,
+				t.matrix[i+1][j]+1,
+				t.matrix[i][j+1]+1,
+				t.matrix[i1][j1]+(i-i1-1)+1+(j-j1-1),
+			)
+			t.matrix[i+1][j+1] = v
+			if v < rowMin {
+				rowMin = v
+			}
+		}
+		t.da[ar[i-1]] = i
+		if rowMin > max {
+			return max + 1, false
+		}
+	}
+
+	d := t.matrix[lenA+1][lenB+1]
+	if d > max {
+		return max + 1, false
+	}
+	return d, true
+}
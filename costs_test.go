@@ -0,0 +1,45 @@
+package tdl
+
+import "testing"
+
+func TestDistanceAtMaxSizeDoesNotPanic(t *testing.T) {
+	d := New(5)
+	if got := d.Distance("abcde", "abcdX"); got != 1 {
+		t.Errorf("Distance at maxSize = %d, want 1", got)
+	}
+	if got := d.DistanceWithCosts("abcde", "abcdX", Costs{Insert: 1, Delete: 1, Substitute: 1, Transpose: 1}); got != 1 {
+		t.Errorf("DistanceWithCosts at maxSize = %d, want 1", got)
+	}
+	if got, ok := d.DistanceWithin("abcde", "abcdX", 5); !ok || got != 1 {
+		t.Errorf("DistanceWithin at maxSize = (%d, %v), want (1, true)", got, ok)
+	}
+}
+
+func TestDistanceWithCostsWeighting(t *testing.T) {
+	d := New(10)
+
+	// With a cheap transpose, swapping "ab" to "ba" should win over two substitutions.
+	cheapTranspose := Costs{Insert: 1, Delete: 1, Substitute: 5, Transpose: 1}
+	if got := d.DistanceWithCosts("ab", "ba", cheapTranspose); got != 1 {
+		t.Errorf("DistanceWithCosts with cheap transpose = %d, want 1", got)
+	}
+
+	// With an expensive transpose, two substitutions should win instead.
+	expensiveTranspose := Costs{Insert: 1, Delete: 1, Substitute: 1, Transpose: 5}
+	if got := d.DistanceWithCosts("ab", "ba", expensiveTranspose); got != 2 {
+		t.Errorf("DistanceWithCosts with expensive transpose = %d, want 2", got)
+	}
+}
+
+func TestDistanceWithinEarlyExit(t *testing.T) {
+	d := New(10)
+
+	if got, ok := d.DistanceWithin("aaaaaaaaaa", "bbbbbbbbbb", 3); ok || got != 4 {
+		t.Errorf(`DistanceWithin("aaaaaaaaaa", "bbbbbbbbbb", 3) = (%d, %v), want (4, false)`, got, ok)
+	}
+
+	// Sanity check against the unbounded distance for the same pair.
+	if full := d.Distance("aaaaaaaaaa", "bbbbbbbbbb"); full <= 3 {
+		t.Fatalf("test fixture is broken: full distance %d should exceed max=3", full)
+	}
+}
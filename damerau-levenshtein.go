@@ -15,6 +15,19 @@ func minimum(is ...int) int {
 	return min
 }
 
+// Distancer is satisfied by every true Damerau–Levenshtein implementation in this
+// package, currently the O(N·M) matrix version returned by New and the O(N+M)
+// version returned by NewLinear.
+type Distancer interface {
+	Distance(a, b string) int
+	DistanceRunes(a, b []rune) int
+}
+
+var (
+	_ Distancer = (*TrueDamerauLevenshtein)(nil)
+	_ Distancer = (*Linear)(nil)
+)
+
 var tdl = New(100)
 
 // Distance is a shortcut func for doing a quick and dirty calculation,
@@ -38,23 +51,36 @@ type TrueDamerauLevenshtein struct {
 
 // New initializes a new struct which allocates memory only once, to be used by
 // Distance().
-// maxSize sets an upper limit for both input strings used in Distance().
+// maxSize sets an upper limit, in runes, for both input strings used in Distance().
 func New(maxSize int) *TrueDamerauLevenshtein {
+	// The matrix is indexed up to maxSize+1 on each axis (a leading sentinel row/column
+	// plus one cell per rune of input up to and including maxSize), so it needs maxSize+2
+	// rows and columns, not maxSize.
 	t := &TrueDamerauLevenshtein{
 		maxSize: maxSize,
-		matrix:  make([][]int, maxSize),
+		matrix:  make([][]int, maxSize+2),
 		da:      make(map[rune]int),
 	}
 	for i := range t.matrix {
-		t.matrix[i] = make([]int, maxSize)
+		t.matrix[i] = make([]int, maxSize+2)
 	}
 	return t
 }
 
 // Distance calculates and returns the true Damerau–Levenshtein distance of string A and B.
 // It's the caller's responsibility if he wants to trim whitespace or fix lower/upper cases.
-// Distance is also free from memory allocs and is pretty quick.
+// Strings are compared rune by rune, so multi-byte UTF-8 characters count as a single edit.
+// Distance converts both inputs to []rune before calling DistanceRunes, so, unlike
+// DistanceRunes itself, it is not free from memory allocs; callers in a hot loop who
+// already have (or can keep) their strings as []rune should call DistanceRunes directly.
 func (t *TrueDamerauLevenshtein) Distance(a, b string) int {
+	return t.DistanceRunes([]rune(a), []rune(b))
+}
+
+// DistanceRunes is the same as Distance, but operates directly on rune slices so callers
+// that already have their input tokenized as runes can skip the []rune(string) conversion.
+// Given pre-converted inputs, it is free from memory allocs and is pretty quick.
+func (t *TrueDamerauLevenshtein) DistanceRunes(a, b []rune) int {
 	lenA, lenB := len(a), len(b)
 	switch {
 	case lenA < 1:
@@ -67,7 +93,10 @@ func (t *TrueDamerauLevenshtein) Distance(a, b string) int {
 		return -1
 	}
 
-	t.matrix[0][0] = lenA + lenB + 1
+	// The sentinel is lenA+lenB rather than lenA+lenB+1: that is already larger than any
+	// distance between the inputs (at most max(lenA, lenB)), so a transposition term read
+	// off the sentinel row/column never wins the minimum.
+	t.matrix[0][0] = lenA + lenB
 	for i := 0; i <= lenA; i++ {
 		t.matrix[i+1][1] = i
 		t.matrix[i+1][0] = t.matrix[0][0]
@@ -77,14 +106,17 @@ func (t *TrueDamerauLevenshtein) Distance(a, b string) int {
 		t.matrix[0][j+1] = t.matrix[0][0]
 	}
 
-	for _, r := range a + b {
+	for _, r := range a {
+		t.da[r] = 0
+	}
+	for _, r := range b {
 		t.da[r] = 0
 	}
 
 	for i := 1; i <= lenA; i++ {
 		db := 0
 		for j := 1; j <= lenB; j++ {
-			i1 := t.da[rune(b[j-1])]
+			i1 := t.da[b[j-1]]
 			j1 := db
 			cost := 1
 			if a[i-1] == b[j-1] {
@@ -100,7 +132,7 @@ func (t *TrueDamerauLevenshtein) Distance(a, b string) int {
 				t.matrix[i1][j1]+(i-i1-1)+1+(j-j1-1), // transposition
 			)
 		}
-		t.da[rune(a[i-1])] = i
+		t.da[a[i-1]] = i
 	}
 	return t.matrix[lenA+1][lenB+1]
 }
@@ -0,0 +1,25 @@
+package tdl
+
+import "testing"
+
+func TestDistanceHandlesMultiByteRunes(t *testing.T) {
+	if got := Distance("café", "cafe"); got != 1 {
+		t.Errorf(`Distance("café", "cafe") = %d, want 1`, got)
+	}
+	if got := New(10).Distance("日本語", "日本後"); got != 1 {
+		t.Errorf(`Distance("日本語", "日本後") = %d, want 1`, got)
+	}
+}
+
+func TestMaxSizeCountsRunesNotBytes(t *testing.T) {
+	d := New(3)
+	// "日本語" is 3 runes but 9 bytes; byte-counting would have rejected it
+	// against a maxSize of 3, but rune-counting must accept it.
+	if got := d.Distance("日本語", "日本"); got != 1 {
+		t.Errorf(`Distance("日本語", "日本") = %d, want 1`, got)
+	}
+	// 4 runes exceeds maxSize 3, so this pair is rejected.
+	if got := d.Distance("日本語後", "日本語"); got != -1 {
+		t.Errorf(`Distance("日本語後", "日本語") = %d, want -1 (over maxSize)`, got)
+	}
+}
@@ -0,0 +1,120 @@
+package tdl
+
+// Linear is a true Damerau–Levenshtein implementation based on the linear-space
+// algorithm described by Chunchun Zhao and Sartaj Sahni in "Linear space string
+// correction algorithm using the Damerau–Levenshtein distance". Instead of an
+// N×M matrix it keeps only two rolling rows of length M, plus one cached row per
+// distinct rune in A (the rune's previous row goes back to an internal free list
+// as that rune recurs, and all cached rows are recycled across calls through it), so steady-state space is O(N+M) for natural
+// text where A's alphabet is small and bounded, the case this targets, and
+// degrades towards New's O(N·M) only when A is made up of mostly-distinct runes.
+// Just like TrueDamerauLevenshtein, Linear is not thread safe; the caller owns that.
+type Linear struct {
+	maxSize int
+	prev    []int
+	curr    []int
+	da      map[rune]int   // rune -> last row in a where it occurred
+	lastRow map[rune][]int // rune -> cached row above the one da refers to
+	free    [][]int        // recycled row buffers, reused instead of reallocated
+}
+
+// NewLinear initializes a Linear calculator which preallocates its rolling rows
+// only once, to be reused by every call to Distance/DistanceRunes.
+// maxSize sets an upper limit, in runes, for both input strings used in Distance().
+func NewLinear(maxSize int) *Linear {
+	return &Linear{
+		maxSize: maxSize,
+		prev:    make([]int, maxSize+1),
+		curr:    make([]int, maxSize+1),
+		da:      make(map[rune]int),
+		lastRow: make(map[rune][]int),
+	}
+}
+
+// Distance calculates and returns the true Damerau–Levenshtein distance of string A and B.
+// It's the caller's responsibility if he wants to trim whitespace or fix lower/upper cases.
+func (t *Linear) Distance(a, b string) int {
+	return t.DistanceRunes([]rune(a), []rune(b))
+}
+
+// borrowRow returns a row buffer of length n, taking one from the free list
+// when possible instead of allocating.
+func (t *Linear) borrowRow(n int) []int {
+	if l := len(t.free); l > 0 {
+		row := t.free[l-1]
+		t.free = t.free[:l-1]
+		return row[:n]
+	}
+	return make([]int, n, t.maxSize+1)
+}
+
+// DistanceRunes is the same as Distance, but operates directly on rune slices so callers
+// that already have their input tokenized as runes can skip the []rune(string) conversion.
+func (t *Linear) DistanceRunes(a, b []rune) int {
+	lenA, lenB := len(a), len(b)
+	switch {
+	case lenA < 1:
+		return lenB
+	case lenB < 1:
+		return lenA
+	case lenA > t.maxSize:
+		return -1
+	case lenB > t.maxSize:
+		return -1
+	}
+
+	for r, row := range t.lastRow {
+		t.free = append(t.free, row)
+		delete(t.lastRow, r)
+	}
+	for r := range t.da {
+		delete(t.da, r)
+	}
+
+	prev, curr := t.prev[:lenB+1], t.curr[:lenB+1]
+	for j := 0; j <= lenB; j++ {
+		prev[j] = j
+	}
+
+	for i := 1; i <= lenA; i++ {
+		// Snapshot the row above this one before it's overwritten: a later row that
+		// looks up da[b[j-1]] == i needs exactly this row to resolve its transposition
+		// term, the same cell the matrix version reads out of t.matrix[i][*].
+		above := t.borrowRow(lenB + 1)
+		copy(above, prev)
+
+		curr[0] = i
+		db := 0 // last column, within this row, where a[i-1] matched b[j-1]; reset every row
+		for j := 1; j <= lenB; j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			// By "conventional wisdom", the costs for the ins/del/trans operations are always +1
+			best := minimum(
+				prev[j-1]+cost, // substitution
+				curr[j-1]+1,    // insertion
+				prev[j]+1,      // deletion
+			)
+
+			if i1, j1 := t.da[b[j-1]], db; i1 > 0 && j1 > 0 {
+				row := t.lastRow[b[j-1]]
+				best = minimum(best, row[j1-1]+(i-i1-1)+1+(j-j1-1)) // transposition
+			}
+			curr[j] = best
+
+			if cost == 0 {
+				db = j
+			}
+		}
+
+		if old, ok := t.lastRow[a[i-1]]; ok {
+			t.free = append(t.free, old)
+		}
+		t.da[a[i-1]] = i
+		t.lastRow[a[i-1]] = above
+		prev, curr = curr, prev
+	}
+	return prev[lenB]
+}
@@ -0,0 +1,39 @@
+package tdl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func randomString(n int) string {
+	r := rand.New(rand.NewSource(42))
+	letters := []rune("abcdefghijklmnopqrstuvwxyz")
+	out := make([]rune, n)
+	for i := range out {
+		out[i] = letters[r.Intn(len(letters))]
+	}
+	return string(out)
+}
+
+func benchmarkMatrix(b *testing.B, size int) {
+	a, c := randomString(size), randomString(size)
+	t := New(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t.Distance(a, c)
+	}
+}
+
+func benchmarkLinear(b *testing.B, size int) {
+	a, c := randomString(size), randomString(size)
+	t := NewLinear(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		t.Distance(a, c)
+	}
+}
+
+func BenchmarkMatrix100(b *testing.B)  { benchmarkMatrix(b, 100) }
+func BenchmarkLinear100(b *testing.B)  { benchmarkLinear(b, 100) }
+func BenchmarkMatrix1000(b *testing.B) { benchmarkMatrix(b, 1000) }
+func BenchmarkLinear1000(b *testing.B) { benchmarkLinear(b, 1000) }
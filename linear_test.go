@@ -0,0 +1,64 @@
+package tdl
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestLinearMatchesKnownDistances(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"a", "", 1},
+		{"", "abc", 3},
+		{"CA", "ABC", 2},
+		{"kitten", "sitting", 3},
+		{"ca", "abc", 2},
+	}
+
+	for _, c := range cases {
+		got := NewLinear(20).Distance(c.a, c.b)
+		if got != c.want {
+			t.Errorf("NewLinear.Distance(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestLinearMatchesMatrix(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	alphabet := []rune("abc")
+	randStr := func(maxLen int) string {
+		n := r.Intn(maxLen + 1)
+		out := make([]rune, n)
+		for i := range out {
+			out[i] = alphabet[r.Intn(len(alphabet))]
+		}
+		return string(out)
+	}
+
+	matrix := New(20)
+	linear := NewLinear(20)
+	for i := 0; i < 2000; i++ {
+		a, b := randStr(14), randStr(14)
+		want := matrix.Distance(a, b)
+		got := linear.Distance(a, b)
+		if got != want {
+			t.Fatalf("mismatch for a=%q b=%q: matrix=%d linear=%d", a, b, want, got)
+		}
+	}
+}
+
+func TestLinearReusesRowBuffersAcrossCalls(t *testing.T) {
+	l := NewLinear(20)
+	l.Distance("banana", "bandana")
+	if len(l.free) == 0 {
+		t.Fatalf("expected recycled row buffers in the free list after a call")
+	}
+	total := len(l.free) + len(l.lastRow)
+	l.Distance("banana", "bandana")
+	if got := len(l.free) + len(l.lastRow); got != total {
+		t.Fatalf("second call grew the row buffers from %d to %d, want them reused", total, got)
+	}
+}
@@ -0,0 +1,43 @@
+package tdl
+
+import "sync"
+
+// reset clears per-call state so a TrueDamerauLevenshtein can be safely handed
+// back to a Pool and reused by a different call. The matrix rows don't need
+// clearing: Distance overwrites every cell it reads before reading it.
+func (t *TrueDamerauLevenshtein) reset() {
+	for r := range t.da {
+		delete(t.da, r)
+	}
+}
+
+// Pool hands out TrueDamerauLevenshtein scratch structs from a sync.Pool, so that,
+// unlike the package-level Distance or a shared TrueDamerauLevenshtein, it is safe
+// to call Pool.Distance concurrently from multiple goroutines: every call borrows
+// its own scratch struct for the duration of the call and never shares one across
+// two in-flight calls.
+type Pool struct {
+	maxSize int
+	pool    sync.Pool
+}
+
+// NewPool builds a Pool whose scratch structs are limited to maxSize runes per
+// input, same as New.
+func NewPool(maxSize int) *Pool {
+	p := &Pool{maxSize: maxSize}
+	p.pool.New = func() interface{} {
+		return New(p.maxSize)
+	}
+	return p
+}
+
+// Distance calculates and returns the true Damerau–Levenshtein distance of string A
+// and B. Safe to call from multiple goroutines at once.
+func (p *Pool) Distance(a, b string) int {
+	t := p.pool.Get().(*TrueDamerauLevenshtein)
+	defer func() {
+		t.reset()
+		p.pool.Put(t)
+	}()
+	return t.Distance(a, b)
+}
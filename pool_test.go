@@ -0,0 +1,41 @@
+package tdl
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestPoolDistanceConcurrent(t *testing.T) {
+	p := NewPool(32)
+	pairs := []struct{ a, b string }{
+		{"kitten", "sitting"},
+		{"CA", "ABC"},
+		{"café", "cafe"},
+		{"damerau", "levenshtein"},
+		{"", "abc"},
+	}
+	want := make([]int, len(pairs))
+	single := New(32)
+	for i, pr := range pairs {
+		want[i] = single.Distance(pr.a, pr.b)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, len(pairs)*20)
+	for g := 0; g < 20; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i, pr := range pairs {
+				if got := p.Distance(pr.a, pr.b); got != want[i] {
+					errs <- "mismatch for " + pr.a + "/" + pr.b
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		t.Error(e)
+	}
+}